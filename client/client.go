@@ -0,0 +1,186 @@
+package nearapi
+
+import (
+	"bytes"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// Client is a minimal JSON-RPC client for a NEAR node's RPC endpoint.
+type Client struct {
+	url        string
+	httpClient *http.Client
+}
+
+// Options configures how a Client reaches its RPC endpoint: the per-request
+// timeout (covering the whole request, not just the dial), optional HTTP
+// auth, custom headers (for providers that gate access behind an API key),
+// and TLS trust.
+type Options struct {
+	Timeout            time.Duration
+	BasicAuthUsername  string
+	BasicAuthPassword  string
+	BearerToken        string
+	Headers            map[string]string
+	CAFile             string
+	InsecureSkipVerify bool
+}
+
+// NewClient returns a Client that talks to the NEAR RPC endpoint at url
+// using opts.
+func NewClient(url string, opts Options) (*Client, error) {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if opts.CAFile != "" || opts.InsecureSkipVerify {
+		tlsConfig := &tls.Config{InsecureSkipVerify: opts.InsecureSkipVerify}
+		if opts.CAFile != "" {
+			caCert, err := os.ReadFile(opts.CAFile)
+			if err != nil {
+				return nil, fmt.Errorf("reading ca_file for %s: %w", url, err)
+			}
+			pool := x509.NewCertPool()
+			if !pool.AppendCertsFromPEM(caCert) {
+				return nil, fmt.Errorf("no certificates found in ca_file for %s", url)
+			}
+			tlsConfig.RootCAs = pool
+		}
+		transport.TLSClientConfig = tlsConfig
+	}
+
+	var rt http.RoundTripper = transport
+	if opts.BasicAuthUsername != "" || opts.BearerToken != "" || len(opts.Headers) > 0 {
+		rt = &authTransport{
+			base:        transport,
+			basicUser:   opts.BasicAuthUsername,
+			basicPass:   opts.BasicAuthPassword,
+			bearerToken: opts.BearerToken,
+			headers:     opts.Headers,
+		}
+	}
+
+	return &Client{
+		url:        url,
+		httpClient: &http.Client{Timeout: opts.Timeout, Transport: rt},
+	}, nil
+}
+
+// authTransport attaches per-endpoint auth and custom headers to every
+// request before handing it off to base.
+type authTransport struct {
+	base        http.RoundTripper
+	basicUser   string
+	basicPass   string
+	bearerToken string
+	headers     map[string]string
+}
+
+func (t *authTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	req = req.Clone(req.Context())
+	if t.basicUser != "" {
+		req.SetBasicAuth(t.basicUser, t.basicPass)
+	}
+	if t.bearerToken != "" {
+		req.Header.Set("Authorization", "Bearer "+t.bearerToken)
+	}
+	for key, value := range t.headers {
+		req.Header.Set(key, value)
+	}
+	return t.base.RoundTrip(req)
+}
+
+type rpcRequest struct {
+	JsonRpc string      `json:"jsonrpc"`
+	Id      string      `json:"id"`
+	Method  string      `json:"method"`
+	Params  interface{} `json:"params"`
+}
+
+type rpcError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+type rpcResponse struct {
+	JsonRpc string          `json:"jsonrpc"`
+	Id      string          `json:"id"`
+	Result  json.RawMessage `json:"result"`
+	Error   *rpcError       `json:"error"`
+}
+
+// Response wraps the result of a single RPC call. Only the field matching
+// the requested method is populated.
+type Response struct {
+	Status         StatusResult
+	Validators     ValidatorsResult
+	ProtocolConfig ProtocolConfigResult
+	GasPrice       GasPriceResult
+}
+
+// Get performs a JSON-RPC call for the given method, passing params as the
+// request's "params" field, and decodes the result into the matching field
+// of Response.
+func (c *Client) Get(method string, params interface{}) (*Response, error) {
+	resp, err := c.get(method, params)
+	if err != nil {
+		RequestErrors.WithLabelValues(method).Inc()
+	}
+	return resp, err
+}
+
+func (c *Client) get(method string, params interface{}) (*Response, error) {
+	timer := prometheus.NewTimer(RequestDuration.WithLabelValues(method))
+	defer timer.ObserveDuration()
+
+	reqBody := rpcRequest{
+		JsonRpc: "2.0",
+		Id:      "near-exporter",
+		Method:  method,
+		Params:  params,
+	}
+
+	body, err := json.Marshal(reqBody)
+	if err != nil {
+		return nil, err
+	}
+
+	httpResp, err := c.httpClient.Post(c.url, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	defer httpResp.Body.Close()
+
+	var rpcResp rpcResponse
+	if err := json.NewDecoder(httpResp.Body).Decode(&rpcResp); err != nil {
+		return nil, err
+	}
+	if rpcResp.Error != nil {
+		return nil, fmt.Errorf("near rpc error calling %s: %s", method, rpcResp.Error.Message)
+	}
+
+	resp := &Response{}
+	switch method {
+	case "status":
+		if err := json.Unmarshal(rpcResp.Result, &resp.Status); err != nil {
+			return nil, err
+		}
+	case "validators":
+		if err := json.Unmarshal(rpcResp.Result, &resp.Validators); err != nil {
+			return nil, err
+		}
+	case "EXPERIMENTAL_protocol_config":
+		if err := json.Unmarshal(rpcResp.Result, &resp.ProtocolConfig); err != nil {
+			return nil, err
+		}
+	case "gas_price":
+		if err := json.Unmarshal(rpcResp.Result, &resp.GasPrice); err != nil {
+			return nil, err
+		}
+	}
+	return resp, nil
+}
@@ -0,0 +1,75 @@
+package nearapi
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+)
+
+func TestNewClientAppliesBasicAuthAndHeaders(t *testing.T) {
+	var gotUser, gotPass string
+	var gotHeader string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotUser, gotPass, _ = r.BasicAuth()
+		gotHeader = r.Header.Get("X-Api-Key")
+		fmt.Fprint(w, `{"jsonrpc":"2.0","id":"near-exporter","result":{"version":{"version":"1.0","build":"abc"}}}`)
+	}))
+	defer server.Close()
+
+	client, err := NewClient(server.URL, Options{
+		BasicAuthUsername: "user",
+		BasicAuthPassword: "pass",
+		Headers:           map[string]string{"X-Api-Key": "secret"},
+	})
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+
+	if _, err := client.Get("status", nil); err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+	if gotUser != "user" || gotPass != "pass" {
+		t.Errorf("basic auth = %q/%q, want %q/%q", gotUser, gotPass, "user", "pass")
+	}
+	if gotHeader != "secret" {
+		t.Errorf("X-Api-Key header = %q, want %q", gotHeader, "secret")
+	}
+}
+
+func TestNewClientAppliesBearerToken(t *testing.T) {
+	var gotAuth string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotAuth = r.Header.Get("Authorization")
+		fmt.Fprint(w, `{"jsonrpc":"2.0","id":"near-exporter","result":{"version":{"version":"1.0","build":"abc"}}}`)
+	}))
+	defer server.Close()
+
+	client, err := NewClient(server.URL, Options{BearerToken: "tok"})
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+	if _, err := client.Get("status", nil); err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+	if want := "Bearer tok"; gotAuth != want {
+		t.Errorf("Authorization header = %q, want %q", gotAuth, want)
+	}
+}
+
+func TestNewClientInvalidCAFile(t *testing.T) {
+	if _, err := NewClient("https://example.invalid", Options{CAFile: "/nonexistent/ca.pem"}); err == nil {
+		t.Fatal("NewClient returned nil error, want error for a missing ca_file")
+	}
+}
+
+func TestNewClientEmptyCAFileIsRejected(t *testing.T) {
+	f := t.TempDir() + "/empty-ca.pem"
+	if err := os.WriteFile(f, []byte("not a certificate"), 0o600); err != nil {
+		t.Fatalf("writing test ca_file: %v", err)
+	}
+	if _, err := NewClient("https://example.invalid", Options{CAFile: f}); err == nil {
+		t.Fatal("NewClient returned nil error, want error for a ca_file with no valid certificates")
+	}
+}
@@ -0,0 +1,23 @@
+package nearapi
+
+import "github.com/prometheus/client_golang/prometheus"
+
+// RequestDuration and RequestErrors are shared by every Client instance so
+// callers can register them once alongside their own collectors.
+var (
+	RequestDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name: "near_rpc_request_duration_seconds",
+			Help: "Duration of NEAR JSON-RPC requests, by method.",
+		},
+		[]string{"method"},
+	)
+
+	RequestErrors = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "near_rpc_errors_total",
+			Help: "Total number of NEAR JSON-RPC requests that returned an error, by method.",
+		},
+		[]string{"method"},
+	)
+)
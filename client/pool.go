@@ -0,0 +1,61 @@
+package nearapi
+
+import (
+	"fmt"
+	"log"
+	"sync/atomic"
+)
+
+// Pool round-robins calls across a set of external RPC endpoints, falling
+// over to the next endpoint if one errors, so a single public RPC provider
+// outage doesn't take down external-facing metrics.
+type Pool struct {
+	endpoints []*Client
+	urls      []string
+	next      uint64
+}
+
+// NewPool returns a Pool over endpoints, identified in metrics by the
+// corresponding entry in urls.
+func NewPool(endpoints []*Client, urls []string) *Pool {
+	return &Pool{endpoints: endpoints, urls: urls}
+}
+
+// Get calls method against the pool's endpoints in round-robin order,
+// trying the next endpoint on error, and returns the response along with
+// the URL of the endpoint that served it.
+func (p *Pool) Get(method string, params interface{}) (*Response, string, error) {
+	n := len(p.endpoints)
+	if n == 0 {
+		return nil, "", fmt.Errorf("nearapi: pool has no endpoints")
+	}
+
+	start := int(atomic.AddUint64(&p.next, 1)-1) % n
+	var err error
+	for i := 0; i < n; i++ {
+		idx := (start + i) % n
+		var resp *Response
+		resp, err = p.endpoints[idx].Get(method, params)
+		if err == nil {
+			return resp, p.urls[idx], nil
+		}
+		log.Printf("error calling %s on %s, trying next endpoint: %v", method, p.urls[idx], err)
+	}
+	return nil, "", err
+}
+
+// StatusAll calls the "status" method against every endpoint in the pool
+// independently, keyed by endpoint URL, so metrics can compare lag against
+// each configured external RPC simultaneously.
+func (p *Pool) StatusAll() map[string]StatusResult {
+	statuses := make(map[string]StatusResult, len(p.endpoints))
+	for i, endpoint := range p.endpoints {
+		resp, err := endpoint.Get("status", nil)
+		if err != nil {
+			log.Printf("error polling status on %s: %v", p.urls[i], err)
+			continue
+		}
+		statuses[p.urls[i]] = resp.Status
+	}
+	return statuses
+}
@@ -0,0 +1,104 @@
+package nearapi
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// newStatusServer returns a test server that answers the "status" RPC
+// method, or a JSON-RPC error if fail is true.
+func newStatusServer(t *testing.T, fail bool) *httptest.Server {
+	t.Helper()
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if fail {
+			fmt.Fprint(w, `{"jsonrpc":"2.0","id":"near-exporter","error":{"code":-1,"message":"boom"}}`)
+			return
+		}
+		fmt.Fprint(w, `{"jsonrpc":"2.0","id":"near-exporter","result":{"version":{"version":"1.0","build":"abc"}}}`)
+	}))
+}
+
+func poolClient(t *testing.T, url string) *Client {
+	t.Helper()
+	client, err := NewClient(url, Options{})
+	if err != nil {
+		t.Fatalf("NewClient(%s): %v", url, err)
+	}
+	return client
+}
+
+func TestPoolGetFailsOverToNextEndpoint(t *testing.T) {
+	bad := newStatusServer(t, true)
+	defer bad.Close()
+	good := newStatusServer(t, false)
+	defer good.Close()
+
+	pool := NewPool(
+		[]*Client{poolClient(t, bad.URL), poolClient(t, good.URL)},
+		[]string{bad.URL, good.URL},
+	)
+
+	resp, source, err := pool.Get("status", nil)
+	if err != nil {
+		t.Fatalf("Get returned error, want failover to succeeding endpoint: %v", err)
+	}
+	if source != good.URL {
+		t.Errorf("source = %q, want %q", source, good.URL)
+	}
+	if resp.Status.Version.Version != "1.0" {
+		t.Errorf("Status.Version.Version = %q, want %q", resp.Status.Version.Version, "1.0")
+	}
+}
+
+func TestPoolGetReturnsLastErrorWhenAllEndpointsFail(t *testing.T) {
+	bad1 := newStatusServer(t, true)
+	defer bad1.Close()
+	bad2 := newStatusServer(t, true)
+	defer bad2.Close()
+
+	pool := NewPool(
+		[]*Client{poolClient(t, bad1.URL), poolClient(t, bad2.URL)},
+		[]string{bad1.URL, bad2.URL},
+	)
+
+	_, source, err := pool.Get("status", nil)
+	if err == nil {
+		t.Fatal("Get returned nil error, want error when every endpoint fails")
+	}
+	if source != "" {
+		t.Errorf("source = %q, want empty string on total failure", source)
+	}
+}
+
+func TestPoolGetRoundRobinsAcrossCalls(t *testing.T) {
+	first := newStatusServer(t, false)
+	defer first.Close()
+	second := newStatusServer(t, false)
+	defer second.Close()
+
+	pool := NewPool(
+		[]*Client{poolClient(t, first.URL), poolClient(t, second.URL)},
+		[]string{first.URL, second.URL},
+	)
+
+	_, source1, err := pool.Get("status", nil)
+	if err != nil {
+		t.Fatalf("first Get: %v", err)
+	}
+	_, source2, err := pool.Get("status", nil)
+	if err != nil {
+		t.Fatalf("second Get: %v", err)
+	}
+	if source1 == source2 {
+		t.Errorf("round-robin served the same endpoint twice in a row: %q", source1)
+	}
+}
+
+func TestPoolGetNoEndpoints(t *testing.T) {
+	pool := NewPool(nil, nil)
+	if _, _, err := pool.Get("status", nil); err == nil {
+		t.Fatal("Get returned nil error, want error for an empty pool")
+	}
+}
@@ -0,0 +1,88 @@
+package nearapi
+
+// StatusResult is the decoded result of the "status" RPC method.
+type StatusResult struct {
+	Version  VersionInfo `json:"version"`
+	SyncInfo SyncInfo    `json:"sync_info"`
+}
+
+type VersionInfo struct {
+	Version string `json:"version"`
+	Build   string `json:"build"`
+}
+
+type SyncInfo struct {
+	LatestBlockHeight uint64 `json:"latest_block_height"`
+	Syncing           bool   `json:"syncing"`
+}
+
+// CurrentValidator describes a single validator in the active set, as
+// returned by the "validators" RPC method.
+type CurrentValidator struct {
+	AccountId         string   `json:"account_id"`
+	PublicKey         string   `json:"public_key"`
+	Stake             string   `json:"stake"`
+	Shards            []uint64 `json:"shards"`
+	NumProducedBlocks uint64   `json:"num_produced_blocks"`
+	NumExpectedBlocks uint64   `json:"num_expected_blocks"`
+	NumProducedChunks uint64   `json:"num_produced_chunks"`
+	NumExpectedChunks uint64   `json:"num_expected_chunks"`
+}
+
+// NextValidator describes a validator that has been confirmed for the next
+// epoch's active set.
+type NextValidator struct {
+	AccountId string   `json:"account_id"`
+	PublicKey string   `json:"public_key"`
+	Stake     string   `json:"stake"`
+	Shards    []uint64 `json:"shards"`
+}
+
+// Proposal describes a pending validator proposal for a future epoch.
+type Proposal struct {
+	AccountId string `json:"account_id"`
+	PublicKey string `json:"public_key"`
+	Stake     string `json:"stake"`
+}
+
+// Fisherman describes an account registered as a fisherman for an epoch.
+type Fisherman struct {
+	AccountId string `json:"account_id"`
+	PublicKey string `json:"public_key"`
+	Stake     string `json:"stake"`
+}
+
+// KickoutInfo describes a validator kicked out of the active set at the end
+// of the previous epoch, along with the reason it was kicked out.
+type KickoutInfo struct {
+	AccountId string                            `json:"account_id"`
+	Reason    map[string]map[string]interface{} `json:"reason"`
+}
+
+// ValidatorsResult is the decoded result of the "validators" RPC method.
+type ValidatorsResult struct {
+	EpochStartHeight  uint64             `json:"epoch_start_height"`
+	CurrentValidators []CurrentValidator `json:"current_validators"`
+	NextValidators    []NextValidator    `json:"next_validators"`
+	CurrentProposals  []Proposal         `json:"current_proposals"`
+	PrevEpochKickOut  []KickoutInfo      `json:"prev_epoch_kickout"`
+	CurrentFishermen  []Fisherman        `json:"current_fishermen"`
+	NextFishermen     []Fisherman        `json:"next_fishermen"`
+}
+
+// ProtocolConfigResult is the decoded result of the
+// "EXPERIMENTAL_protocol_config" RPC method. MaxInflationRate is encoded by
+// the RPC as a [numerator, denominator] pair rather than an object.
+type ProtocolConfigResult struct {
+	ProtocolVersion               uint64    `json:"protocol_version"`
+	EpochLength                   uint64    `json:"epoch_length"`
+	NumBlockProducerSeats         uint64    `json:"num_block_producer_seats"`
+	NumBlockProducerSeatsPerShard []uint64  `json:"num_block_producer_seats_per_shard"`
+	MinGasPrice                   string    `json:"min_gas_price"`
+	MaxInflationRate              [2]uint64 `json:"max_inflation_rate"`
+}
+
+// GasPriceResult is the decoded result of the "gas_price" RPC method.
+type GasPriceResult struct {
+	GasPrice string `json:"gas_price"`
+}
@@ -0,0 +1,71 @@
+package collector
+
+import (
+	"math/big"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// HeadCollector exposes the internal and external node's chain head and how
+// far behind the internal node is.
+type HeadCollector struct {
+	snapshot *snapshot
+
+	blockHeightInternalDesc *prometheus.Desc
+	blockHeightExternalDesc *prometheus.Desc
+	blockLagDesc            *prometheus.Desc
+}
+
+func NewHeadCollector(snapshot *snapshot) *HeadCollector {
+	return &HeadCollector{
+		snapshot: snapshot,
+		blockHeightInternalDesc: prometheus.NewDesc(
+			"near_block_height_internal",
+			"The head of the NEAR chain according to the internal node",
+			nil,
+			nil,
+		),
+		blockHeightExternalDesc: prometheus.NewDesc(
+			"near_block_height_external",
+			"The head of the NEAR chain according to an external node",
+			[]string{"endpoint"},
+			nil,
+		),
+		blockLagDesc: prometheus.NewDesc(
+			"near_block_lag",
+			"The number of blocks the internal node is behind an external node.",
+			[]string{"endpoint"},
+			nil,
+		),
+	}
+}
+
+func (collector *HeadCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- collector.blockHeightInternalDesc
+	ch <- collector.blockHeightExternalDesc
+	ch <- collector.blockLagDesc
+}
+
+func (collector *HeadCollector) Collect(ch chan<- prometheus.Metric) {
+	internalStatus, externalStatuses, _, err := collector.snapshot.get()
+	if err != nil {
+		ch <- prometheus.NewInvalidMetric(collector.blockHeightInternalDesc, err)
+		ch <- prometheus.NewInvalidMetric(collector.blockHeightExternalDesc, err)
+		ch <- prometheus.NewInvalidMetric(collector.blockLagDesc, err)
+		return
+	}
+
+	intBlockHeight := internalStatus.SyncInfo.LatestBlockHeight
+	ch <- prometheus.MustNewConstMetric(collector.blockHeightInternalDesc, prometheus.GaugeValue, float64(intBlockHeight))
+
+	bigIntHeight := new(big.Int).SetUint64(intBlockHeight)
+	for endpoint, status := range externalStatuses {
+		extBlockHeight := status.SyncInfo.LatestBlockHeight
+		ch <- prometheus.MustNewConstMetric(collector.blockHeightExternalDesc, prometheus.GaugeValue, float64(extBlockHeight), endpoint)
+
+		bigExtHeight := new(big.Int).SetUint64(extBlockHeight)
+		blockLag := big.NewInt(0).Sub(bigExtHeight, bigIntHeight)
+		blockLagFloat64, _ := new(big.Float).SetInt(blockLag).Float64()
+		ch <- prometheus.MustNewConstMetric(collector.blockLagDesc, prometheus.GaugeValue, blockLagFloat64, endpoint)
+	}
+}
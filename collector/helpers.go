@@ -0,0 +1,34 @@
+package collector
+
+import (
+	"hash/fnv"
+	"math/big"
+)
+
+// HashString collapses a string (e.g. a node's version build hash) into a
+// uint32 so it can be used as a Prometheus gauge value, with the original
+// string preserved as a label.
+func HashString(s string) uint32 {
+	h := fnv.New32a()
+	h.Write([]byte(s))
+	return h.Sum32()
+}
+
+// GetStakeFromString parses a yoctoNEAR stake amount, as returned by the
+// RPC as a u128 string, into a float64 suitable for a Prometheus gauge.
+func GetStakeFromString(s string) float64 {
+	stake, ok := new(big.Int).SetString(s, 10)
+	if !ok {
+		return 0
+	}
+	f := new(big.Float).SetInt(stake)
+	result, _ := f.Float64()
+	return result
+}
+
+func boolToFloat64(b bool) float64 {
+	if b {
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,86 @@
+package collector
+
+import (
+	"fmt"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// KickoutCollector exposes validators kicked out of the active set at the
+// end of the previous epoch, along with the reason they were kicked out.
+type KickoutCollector struct {
+	snapshot *snapshot
+
+	prevEpochKickoutDesc *prometheus.Desc
+}
+
+func NewKickoutCollector(snapshot *snapshot) *KickoutCollector {
+	return &KickoutCollector{
+		snapshot: snapshot,
+		prevEpochKickoutDesc: prometheus.NewDesc(
+			"near_prev_epoch_kickout",
+			"Near previous epoch kicked out validators",
+			[]string{"account_id", "reason", "produced", "expected", "stake_u128", "threshold_u128"},
+			nil,
+		),
+	}
+}
+
+func (collector *KickoutCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- collector.prevEpochKickoutDesc
+}
+
+func (collector *KickoutCollector) Collect(ch chan<- prometheus.Metric) {
+	_, _, validators, err := collector.snapshot.get()
+	if err != nil {
+		ch <- prometheus.NewInvalidMetric(collector.prevEpochKickoutDesc, err)
+		return
+	}
+
+	for _, v := range validators.PrevEpochKickOut {
+		if reason, ok := v.Reason["NotEnoughStake"]; ok {
+			stake := reasonStringField(reason, "stake_u128")
+			threshold := reasonStringField(reason, "threshold_u128")
+			ch <- prometheus.MustNewConstMetric(collector.prevEpochKickoutDesc, prometheus.GaugeValue,
+				GetStakeFromString(stake), v.AccountId, "NotEnoughStake", "", "", stake, threshold)
+
+		} else if val, ok := v.Reason["NotEnoughBlocks"]; ok {
+			produced := reasonFloatField(val, "produced")
+			expected := reasonFloatField(val, "expected")
+			ch <- prometheus.MustNewConstMetric(collector.prevEpochKickoutDesc, prometheus.GaugeValue,
+				produced, v.AccountId, "NotEnoughBlocks", fmt.Sprintf("%v", produced), fmt.Sprintf("%v", expected), "", "")
+
+		} else if val, ok := v.Reason["NotEnoughChunks"]; ok {
+			produced := reasonFloatField(val, "produced")
+			expected := reasonFloatField(val, "expected")
+			ch <- prometheus.MustNewConstMetric(collector.prevEpochKickoutDesc, prometheus.GaugeValue,
+				produced, v.AccountId, "NotEnoughChunks", fmt.Sprintf("%v", produced), fmt.Sprintf("%v", expected), "", "")
+
+		} else if _, ok := v.Reason["Slashed"]; ok {
+			ch <- prometheus.MustNewConstMetric(collector.prevEpochKickoutDesc, prometheus.GaugeValue,
+				1, v.AccountId, "Slashed", "", "", "", "")
+		}
+	}
+}
+
+// reasonFloatField reads a float64 field out of a kickout reason's inner
+// map, defaulting to 0 if the key is absent or not a float64, so a missing
+// key never reaches a bare type assertion.
+func reasonFloatField(reason map[string]interface{}, key string) float64 {
+	v, ok := reason[key].(float64)
+	if !ok {
+		return 0
+	}
+	return v
+}
+
+// reasonStringField reads a string field out of a kickout reason's inner
+// map, defaulting to "" if the key is absent or not a string, so a missing
+// key never reaches a bare type assertion.
+func reasonStringField(reason map[string]interface{}, key string) string {
+	v, ok := reason[key].(string)
+	if !ok {
+		return ""
+	}
+	return v
+}
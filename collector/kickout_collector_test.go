@@ -0,0 +1,101 @@
+package collector
+
+import (
+	"fmt"
+	"testing"
+
+	nearapi "github.com/bisontrails/near-exporter/client"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// collectMetrics drains a Collector's Collect output into a slice so tests
+// can assert on it without standing up a full registry.
+func collectMetrics(c prometheus.Collector) []prometheus.Metric {
+	ch := make(chan prometheus.Metric, 64)
+	c.Collect(ch)
+	close(ch)
+	var metrics []prometheus.Metric
+	for m := range ch {
+		metrics = append(metrics, m)
+	}
+	return metrics
+}
+
+func kickoutSnapshot(kickouts []nearapi.KickoutInfo) *snapshot {
+	return &snapshot{validators: nearapi.ValidatorsResult{PrevEpochKickOut: kickouts}}
+}
+
+func TestKickoutCollectorNotEnoughStakeMissingThreshold(t *testing.T) {
+	collector := NewKickoutCollector(kickoutSnapshot([]nearapi.KickoutInfo{
+		{
+			AccountId: "alice.near",
+			Reason: map[string]map[string]interface{}{
+				"NotEnoughStake": {"stake_u128": "100"},
+			},
+		},
+	}))
+
+	metrics := collectMetrics(collector)
+	if len(metrics) != 1 {
+		t.Fatalf("len(metrics) = %d, want 1 (a missing threshold_u128 must not panic or drop the metric)", len(metrics))
+	}
+}
+
+func TestKickoutCollectorNotEnoughBlocksNullProduced(t *testing.T) {
+	collector := NewKickoutCollector(kickoutSnapshot([]nearapi.KickoutInfo{
+		{
+			AccountId: "bob.near",
+			Reason: map[string]map[string]interface{}{
+				"NotEnoughBlocks": {"produced": nil, "expected": float64(10)},
+			},
+		},
+	}))
+
+	metrics := collectMetrics(collector)
+	if len(metrics) != 1 {
+		t.Fatalf("len(metrics) = %d, want 1 (a null produced field must not panic or drop the metric)", len(metrics))
+	}
+}
+
+func TestKickoutCollectorNotEnoughChunksMissingProduced(t *testing.T) {
+	collector := NewKickoutCollector(kickoutSnapshot([]nearapi.KickoutInfo{
+		{
+			AccountId: "carol.near",
+			Reason: map[string]map[string]interface{}{
+				"NotEnoughChunks": {"expected": float64(5)},
+			},
+		},
+	}))
+
+	metrics := collectMetrics(collector)
+	if len(metrics) != 1 {
+		t.Fatalf("len(metrics) = %d, want 1 (a missing produced field must not panic or drop the metric)", len(metrics))
+	}
+}
+
+func TestKickoutCollectorSlashed(t *testing.T) {
+	collector := NewKickoutCollector(kickoutSnapshot([]nearapi.KickoutInfo{
+		{
+			AccountId: "dave.near",
+			Reason: map[string]map[string]interface{}{
+				"Slashed": {},
+			},
+		},
+	}))
+
+	metrics := collectMetrics(collector)
+	if len(metrics) != 1 {
+		t.Fatalf("len(metrics) = %d, want 1", len(metrics))
+	}
+}
+
+func TestKickoutCollectorSnapshotError(t *testing.T) {
+	s := &snapshot{}
+	s.setErr(fmt.Errorf("rpc unavailable"))
+	collector := NewKickoutCollector(s)
+
+	metrics := collectMetrics(collector)
+	if len(metrics) != 1 {
+		t.Fatalf("len(metrics) = %d, want 1 invalid metric when the snapshot holds an error", len(metrics))
+	}
+}
@@ -0,0 +1,184 @@
+package collector
+
+import (
+	"log"
+	"strconv"
+	"sync"
+	"time"
+
+	nearapi "github.com/bisontrails/near-exporter/client"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// protocolConfigInterval is how often ProtocolConfigCollector refreshes its
+// own cache. Protocol config and gas price change far less often than chain
+// head or the validator set, so it polls on a longer cycle than snapshotInterval.
+const protocolConfigInterval = 5 * time.Minute
+
+// ProtocolConfigCollector exposes protocol/genesis config parameters and the
+// current gas price, plus how far the chain head is through the current
+// epoch relative to the protocol's epoch length.
+type ProtocolConfigCollector struct {
+	externalPool *nearapi.Pool
+	snapshot     *snapshot
+
+	mu       sync.RWMutex
+	config   nearapi.ProtocolConfigResult
+	gasPrice string
+	err      error
+
+	protocolVersionDesc               *prometheus.Desc
+	epochLengthDesc                   *prometheus.Desc
+	numBlockProducerSeatsDesc         *prometheus.Desc
+	numBlockProducerSeatsPerShardDesc *prometheus.Desc
+	minGasPriceDesc                   *prometheus.Desc
+	currentGasPriceDesc               *prometheus.Desc
+	maxInflationRateDesc              *prometheus.Desc
+	epochProgressRatioDesc            *prometheus.Desc
+}
+
+func NewProtocolConfigCollector(externalPool *nearapi.Pool, snapshot *snapshot) *ProtocolConfigCollector {
+	collector := &ProtocolConfigCollector{
+		externalPool: externalPool,
+		snapshot:     snapshot,
+		protocolVersionDesc: prometheus.NewDesc(
+			"near_protocol_version",
+			"The NEAR protocol version in use",
+			nil,
+			nil,
+		),
+		epochLengthDesc: prometheus.NewDesc(
+			"near_epoch_length_blocks",
+			"The number of blocks in an epoch",
+			nil,
+			nil,
+		),
+		numBlockProducerSeatsDesc: prometheus.NewDesc(
+			"near_num_block_producer_seats",
+			"The total number of block producer seats",
+			nil,
+			nil,
+		),
+		numBlockProducerSeatsPerShardDesc: prometheus.NewDesc(
+			"near_num_block_producer_seats_per_shard",
+			"The number of block producer seats assigned to a shard",
+			[]string{"shard"},
+			nil,
+		),
+		minGasPriceDesc: prometheus.NewDesc(
+			"near_min_gas_price",
+			"The protocol's minimum gas price",
+			nil,
+			nil,
+		),
+		currentGasPriceDesc: prometheus.NewDesc(
+			"near_current_gas_price",
+			"The current gas price",
+			nil,
+			nil,
+		),
+		maxInflationRateDesc: prometheus.NewDesc(
+			"near_max_inflation_rate",
+			"The protocol's maximum yearly inflation rate",
+			nil,
+			nil,
+		),
+		epochProgressRatioDesc: prometheus.NewDesc(
+			"near_epoch_progress_ratio",
+			"How far the chain head is through the current epoch, from 0 to 1",
+			nil,
+			nil,
+		),
+	}
+	go collector.run()
+	return collector
+}
+
+func (collector *ProtocolConfigCollector) run() {
+	for {
+		collector.poll()
+		time.Sleep(protocolConfigInterval)
+	}
+}
+
+func (collector *ProtocolConfigCollector) poll() {
+	configResp, _, err := collector.externalPool.Get("EXPERIMENTAL_protocol_config", map[string]interface{}{"finality": "final"})
+	if err != nil {
+		log.Printf("error polling protocol config: %v", err)
+		collector.setErr(err)
+		return
+	}
+
+	gasPriceResp, _, err := collector.externalPool.Get("gas_price", []interface{}{nil})
+	if err != nil {
+		log.Printf("error polling gas price: %v", err)
+		collector.setErr(err)
+		return
+	}
+
+	collector.mu.Lock()
+	collector.config = configResp.ProtocolConfig
+	collector.gasPrice = gasPriceResp.GasPrice.GasPrice
+	collector.err = nil
+	collector.mu.Unlock()
+}
+
+func (collector *ProtocolConfigCollector) setErr(err error) {
+	collector.mu.Lock()
+	collector.err = err
+	collector.mu.Unlock()
+}
+
+func (collector *ProtocolConfigCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- collector.protocolVersionDesc
+	ch <- collector.epochLengthDesc
+	ch <- collector.numBlockProducerSeatsDesc
+	ch <- collector.numBlockProducerSeatsPerShardDesc
+	ch <- collector.minGasPriceDesc
+	ch <- collector.currentGasPriceDesc
+	ch <- collector.maxInflationRateDesc
+	ch <- collector.epochProgressRatioDesc
+}
+
+func (collector *ProtocolConfigCollector) Collect(ch chan<- prometheus.Metric) {
+	collector.mu.RLock()
+	config, gasPrice, err := collector.config, collector.gasPrice, collector.err
+	collector.mu.RUnlock()
+
+	if err != nil {
+		ch <- prometheus.NewInvalidMetric(collector.protocolVersionDesc, err)
+		ch <- prometheus.NewInvalidMetric(collector.epochLengthDesc, err)
+		ch <- prometheus.NewInvalidMetric(collector.numBlockProducerSeatsDesc, err)
+		ch <- prometheus.NewInvalidMetric(collector.numBlockProducerSeatsPerShardDesc, err)
+		ch <- prometheus.NewInvalidMetric(collector.minGasPriceDesc, err)
+		ch <- prometheus.NewInvalidMetric(collector.currentGasPriceDesc, err)
+		ch <- prometheus.NewInvalidMetric(collector.maxInflationRateDesc, err)
+		ch <- prometheus.NewInvalidMetric(collector.epochProgressRatioDesc, err)
+		return
+	}
+
+	ch <- prometheus.MustNewConstMetric(collector.protocolVersionDesc, prometheus.GaugeValue, float64(config.ProtocolVersion))
+	ch <- prometheus.MustNewConstMetric(collector.epochLengthDesc, prometheus.GaugeValue, float64(config.EpochLength))
+	ch <- prometheus.MustNewConstMetric(collector.numBlockProducerSeatsDesc, prometheus.GaugeValue, float64(config.NumBlockProducerSeats))
+	for shard, seats := range config.NumBlockProducerSeatsPerShard {
+		ch <- prometheus.MustNewConstMetric(collector.numBlockProducerSeatsPerShardDesc, prometheus.GaugeValue, float64(seats), strconv.Itoa(shard))
+	}
+	ch <- prometheus.MustNewConstMetric(collector.minGasPriceDesc, prometheus.GaugeValue, GetStakeFromString(config.MinGasPrice))
+	ch <- prometheus.MustNewConstMetric(collector.currentGasPriceDesc, prometheus.GaugeValue, GetStakeFromString(gasPrice))
+
+	var maxInflationRate float64
+	if config.MaxInflationRate[1] != 0 {
+		maxInflationRate = float64(config.MaxInflationRate[0]) / float64(config.MaxInflationRate[1])
+	}
+	ch <- prometheus.MustNewConstMetric(collector.maxInflationRateDesc, prometheus.GaugeValue, maxInflationRate)
+
+	if config.EpochLength == 0 {
+		return
+	}
+	internalStatus, _, validators, snapErr := collector.snapshot.get()
+	if snapErr != nil {
+		return
+	}
+	progress := float64(internalStatus.SyncInfo.LatestBlockHeight-validators.EpochStartHeight) / float64(config.EpochLength)
+	ch <- prometheus.MustNewConstMetric(collector.epochProgressRatioDesc, prometheus.GaugeValue, progress)
+}
@@ -0,0 +1,84 @@
+package collector
+
+import (
+	"log"
+	"sync"
+	"time"
+
+	nearapi "github.com/bisontrails/near-exporter/client"
+)
+
+// snapshotInterval is how often the background poller refreshes the shared
+// snapshot. It's independent of CLIENT_TIMEOUT_SECONDS, which only bounds
+// how long a single RPC call is allowed to take.
+const snapshotInterval = 15 * time.Second
+
+// snapshot holds the most recently polled RPC state, shared by every
+// per-subsystem collector. Populating it once per interval, rather than once
+// per Collect call, means scrape latency no longer depends on NEAR RPC
+// latency and concurrent scrapers don't multiply load on the node.
+type snapshot struct {
+	mu sync.RWMutex
+
+	internalStatus nearapi.StatusResult
+	externalStatus map[string]nearapi.StatusResult
+	validators     nearapi.ValidatorsResult
+	err            error
+}
+
+// NewSnapshotPoller starts a background goroutine that refreshes a snapshot
+// from internalClient and externalPool every snapshotInterval, and returns
+// it immediately so collectors can be constructed before the first poll
+// completes.
+func NewSnapshotPoller(internalClient *nearapi.Client, externalPool *nearapi.Pool) *snapshot {
+	s := &snapshot{}
+	go s.run(internalClient, externalPool)
+	return s
+}
+
+func (s *snapshot) run(internalClient *nearapi.Client, externalPool *nearapi.Pool) {
+	for {
+		s.poll(internalClient, externalPool)
+		time.Sleep(snapshotInterval)
+	}
+}
+
+func (s *snapshot) poll(internalClient *nearapi.Client, externalPool *nearapi.Pool) {
+	internalStatus, err := internalClient.Get("status", nil)
+	if err != nil {
+		log.Printf("error polling internal status: %v", err)
+		s.setErr(err)
+		return
+	}
+
+	externalStatus := externalPool.StatusAll()
+
+	validators, _, err := externalPool.Get("validators", []uint64{internalStatus.Status.SyncInfo.LatestBlockHeight})
+	if err != nil {
+		log.Printf("error polling validators: %v", err)
+		s.setErr(err)
+		return
+	}
+
+	s.mu.Lock()
+	s.internalStatus = internalStatus.Status
+	s.externalStatus = externalStatus
+	s.validators = validators.Validators
+	s.err = nil
+	s.mu.Unlock()
+}
+
+func (s *snapshot) setErr(err error) {
+	s.mu.Lock()
+	s.err = err
+	s.mu.Unlock()
+}
+
+// get returns the state from the last successful poll together with the
+// error from the most recent poll, which is nil if that poll succeeded. A
+// failed poll leaves the earlier state in place but sets the error.
+func (s *snapshot) get() (nearapi.StatusResult, map[string]nearapi.StatusResult, nearapi.ValidatorsResult, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.internalStatus, s.externalStatus, s.validators, s.err
+}
@@ -0,0 +1,66 @@
+package collector
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	nearapi "github.com/bisontrails/near-exporter/client"
+)
+
+func mustClient(t *testing.T, url string) *nearapi.Client {
+	t.Helper()
+	client, err := nearapi.NewClient(url, nearapi.Options{})
+	if err != nil {
+		t.Fatalf("NewClient(%s): %v", url, err)
+	}
+	return client
+}
+
+func TestSnapshotPollPopulatesState(t *testing.T) {
+	internal := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"jsonrpc":"2.0","id":"near-exporter","result":{"sync_info":{"latest_block_height":100}}}`)
+	}))
+	defer internal.Close()
+
+	external := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"jsonrpc":"2.0","id":"near-exporter","result":{"epoch_start_height":90}}`)
+	}))
+	defer external.Close()
+
+	pool := nearapi.NewPool([]*nearapi.Client{mustClient(t, external.URL)}, []string{external.URL})
+
+	s := &snapshot{}
+	s.poll(mustClient(t, internal.URL), pool)
+
+	internalStatus, externalStatus, validators, err := s.get()
+	if err != nil {
+		t.Fatalf("get() returned error after a successful poll: %v", err)
+	}
+	if internalStatus.SyncInfo.LatestBlockHeight != 100 {
+		t.Errorf("LatestBlockHeight = %d, want 100", internalStatus.SyncInfo.LatestBlockHeight)
+	}
+	if validators.EpochStartHeight != 90 {
+		t.Errorf("EpochStartHeight = %d, want 90", validators.EpochStartHeight)
+	}
+	if _, ok := externalStatus[external.URL]; !ok {
+		t.Errorf("externalStatus missing entry for %s: %v", external.URL, externalStatus)
+	}
+}
+
+func TestSnapshotPollFailureReturnsError(t *testing.T) {
+	failing := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"jsonrpc":"2.0","id":"near-exporter","error":{"code":-1,"message":"boom"}}`)
+	}))
+	defer failing.Close()
+
+	pool := nearapi.NewPool([]*nearapi.Client{mustClient(t, failing.URL)}, []string{failing.URL})
+
+	s := &snapshot{}
+	s.poll(mustClient(t, failing.URL), pool)
+
+	if _, _, _, err := s.get(); err == nil {
+		t.Fatal("get() returned nil error after a failed poll, want the poll error")
+	}
+}
@@ -0,0 +1,37 @@
+package collector
+
+import "github.com/prometheus/client_golang/prometheus"
+
+// SyncCollector exposes whether the internal node reports that it is still
+// syncing (1) or not (0).
+type SyncCollector struct {
+	snapshot *snapshot
+
+	syncingDesc *prometheus.Desc
+}
+
+func NewSyncCollector(snapshot *snapshot) *SyncCollector {
+	return &SyncCollector{
+		snapshot: snapshot,
+		syncingDesc: prometheus.NewDesc(
+			"near_sync_state",
+			"Sync state",
+			nil,
+			nil,
+		),
+	}
+}
+
+func (collector *SyncCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- collector.syncingDesc
+}
+
+func (collector *SyncCollector) Collect(ch chan<- prometheus.Metric) {
+	internalStatus, _, _, err := collector.snapshot.get()
+	if err != nil {
+		ch <- prometheus.NewInvalidMetric(collector.syncingDesc, err)
+		return
+	}
+
+	ch <- prometheus.MustNewConstMetric(collector.syncingDesc, prometheus.GaugeValue, boolToFloat64(internalStatus.SyncInfo.Syncing))
+}
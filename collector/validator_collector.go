@@ -0,0 +1,233 @@
+package collector
+
+import (
+	"strconv"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// ValidatorCollector exposes metrics derived from the active and upcoming
+// validator sets: seat price, the watched accounts' production and stake,
+// and visibility into the next epoch's validators, proposals and fishermen.
+type ValidatorCollector struct {
+	snapshot   *snapshot
+	accountIds []string
+
+	epochStartHeightDesc     *prometheus.Desc
+	seatPriceDesc            *prometheus.Desc
+	currentStakeDesc         *prometheus.Desc
+	epochBlockBroducedDesc   *prometheus.Desc
+	epochBlockExpectedDesc   *prometheus.Desc
+	blocksMissedDesc         *prometheus.Desc
+	nextValidatorStakeDesc   *prometheus.Desc
+	currentProposalStakeDesc *prometheus.Desc
+	fishermanStakeDesc       *prometheus.Desc
+	nextEpochValidatorDesc   *prometheus.Desc
+	chunksProducedDesc       *prometheus.Desc
+	chunksExpectedDesc       *prometheus.Desc
+	chunksMissedDesc         *prometheus.Desc
+	validatorShardsDesc      *prometheus.Desc
+}
+
+func NewValidatorCollector(snapshot *snapshot, accountIds []string) *ValidatorCollector {
+	return &ValidatorCollector{
+		snapshot:   snapshot,
+		accountIds: accountIds,
+		epochStartHeightDesc: prometheus.NewDesc(
+			"near_epoch_start_height",
+			"Near epoch start height",
+			nil,
+			nil,
+		),
+		seatPriceDesc: prometheus.NewDesc(
+			"near_seat_price",
+			"Validator seat price",
+			nil,
+			nil,
+		),
+		currentStakeDesc: prometheus.NewDesc(
+			"near_current_stake",
+			"Current stake of a given account id",
+			[]string{"account_id"},
+			nil,
+		),
+		epochBlockBroducedDesc: prometheus.NewDesc(
+			"near_epoch_block_produced_number",
+			"The number of block produced in epoch",
+			[]string{"account_id"},
+			nil,
+		),
+		epochBlockExpectedDesc: prometheus.NewDesc(
+			"near_epoch_block_expected_number",
+			"The number of block expected in epoch",
+			[]string{"account_id"},
+			nil,
+		),
+		blocksMissedDesc: prometheus.NewDesc(
+			"near_blocks_missed",
+			"The number of blocks missed while validating in the active set.",
+			[]string{"account_id"},
+			nil,
+		),
+		nextValidatorStakeDesc: prometheus.NewDesc(
+			"near_next_validator_stake",
+			"Stake of an account confirmed for the next epoch's validator set",
+			[]string{"account_id", "public_key"},
+			nil,
+		),
+		currentProposalStakeDesc: prometheus.NewDesc(
+			"near_current_proposal_stake",
+			"Proposed stake of an account awaiting inclusion in a future validator set",
+			[]string{"account_id", "public_key"},
+			nil,
+		),
+		fishermanStakeDesc: prometheus.NewDesc(
+			"near_fisherman_stake",
+			"Stake of an account registered as a fisherman",
+			[]string{"account_id", "epoch"},
+			nil,
+		),
+		nextEpochValidatorDesc: prometheus.NewDesc(
+			"near_next_epoch_validator",
+			"Whether accountId is present in the next epoch's validator set",
+			[]string{"account_id"},
+			nil,
+		),
+		chunksProducedDesc: prometheus.NewDesc(
+			"near_epoch_chunks_produced",
+			"The number of chunks produced in epoch across all shards the account is assigned to",
+			[]string{"account_id"},
+			nil,
+		),
+		chunksExpectedDesc: prometheus.NewDesc(
+			"near_epoch_chunks_expected",
+			"The number of chunks expected in epoch across all shards the account is assigned to",
+			[]string{"account_id"},
+			nil,
+		),
+		chunksMissedDesc: prometheus.NewDesc(
+			"near_chunks_missed",
+			"The number of chunks missed while chunk-producing in the active set, across all shards the account is assigned to",
+			[]string{"account_id"},
+			nil,
+		),
+		validatorShardsDesc: prometheus.NewDesc(
+			"near_validator_shards",
+			"The shards an account is assigned to produce chunks for",
+			[]string{"account_id", "shard"},
+			nil,
+		),
+	}
+}
+
+func (collector *ValidatorCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- collector.epochStartHeightDesc
+	ch <- collector.seatPriceDesc
+	ch <- collector.currentStakeDesc
+	ch <- collector.epochBlockBroducedDesc
+	ch <- collector.epochBlockExpectedDesc
+	ch <- collector.blocksMissedDesc
+	ch <- collector.nextValidatorStakeDesc
+	ch <- collector.currentProposalStakeDesc
+	ch <- collector.fishermanStakeDesc
+	ch <- collector.nextEpochValidatorDesc
+	ch <- collector.chunksProducedDesc
+	ch <- collector.chunksExpectedDesc
+	ch <- collector.chunksMissedDesc
+	ch <- collector.validatorShardsDesc
+}
+
+func (collector *ValidatorCollector) Collect(ch chan<- prometheus.Metric) {
+	_, _, validators, err := collector.snapshot.get()
+	if err != nil {
+		ch <- prometheus.NewInvalidMetric(collector.epochStartHeightDesc, err)
+		ch <- prometheus.NewInvalidMetric(collector.seatPriceDesc, err)
+		ch <- prometheus.NewInvalidMetric(collector.currentStakeDesc, err)
+		ch <- prometheus.NewInvalidMetric(collector.epochBlockBroducedDesc, err)
+		ch <- prometheus.NewInvalidMetric(collector.epochBlockExpectedDesc, err)
+		ch <- prometheus.NewInvalidMetric(collector.blocksMissedDesc, err)
+		ch <- prometheus.NewInvalidMetric(collector.nextValidatorStakeDesc, err)
+		ch <- prometheus.NewInvalidMetric(collector.currentProposalStakeDesc, err)
+		ch <- prometheus.NewInvalidMetric(collector.fishermanStakeDesc, err)
+		ch <- prometheus.NewInvalidMetric(collector.nextEpochValidatorDesc, err)
+		ch <- prometheus.NewInvalidMetric(collector.chunksProducedDesc, err)
+		ch <- prometheus.NewInvalidMetric(collector.chunksExpectedDesc, err)
+		ch <- prometheus.NewInvalidMetric(collector.chunksMissedDesc, err)
+		ch <- prometheus.NewInvalidMetric(collector.validatorShardsDesc, err)
+		return
+	}
+
+	ch <- prometheus.MustNewConstMetric(collector.epochStartHeightDesc, prometheus.GaugeValue, float64(validators.EpochStartHeight))
+
+	type watchedValidator struct {
+		pb, eb, stake float64
+		pc, ec        float64
+		shards        []uint64
+	}
+
+	var seatPrice float64
+	watched := make(map[string]watchedValidator, len(collector.accountIds))
+	for _, v := range validators.CurrentValidators {
+		t := GetStakeFromString(v.Stake)
+		if seatPrice == 0 {
+			seatPrice = t
+		}
+		if seatPrice > t {
+			seatPrice = t
+		}
+		for _, accountId := range collector.accountIds {
+			if v.AccountId == accountId {
+				watched[accountId] = watchedValidator{
+					pb:     float64(v.NumProducedBlocks),
+					eb:     float64(v.NumExpectedBlocks),
+					stake:  t,
+					pc:     float64(v.NumProducedChunks),
+					ec:     float64(v.NumExpectedChunks),
+					shards: v.Shards,
+				}
+			}
+		}
+	}
+	ch <- prometheus.MustNewConstMetric(collector.seatPriceDesc, prometheus.GaugeValue, seatPrice)
+
+	for _, accountId := range collector.accountIds {
+		w := watched[accountId]
+		ch <- prometheus.MustNewConstMetric(collector.epochBlockBroducedDesc, prometheus.GaugeValue, w.pb, accountId)
+		ch <- prometheus.MustNewConstMetric(collector.epochBlockExpectedDesc, prometheus.GaugeValue, w.eb, accountId)
+		ch <- prometheus.MustNewConstMetric(collector.blocksMissedDesc, prometheus.GaugeValue, w.eb-w.pb, accountId)
+		ch <- prometheus.MustNewConstMetric(collector.currentStakeDesc, prometheus.GaugeValue, w.stake, accountId)
+		ch <- prometheus.MustNewConstMetric(collector.chunksProducedDesc, prometheus.GaugeValue, w.pc, accountId)
+		ch <- prometheus.MustNewConstMetric(collector.chunksExpectedDesc, prometheus.GaugeValue, w.ec, accountId)
+		ch <- prometheus.MustNewConstMetric(collector.chunksMissedDesc, prometheus.GaugeValue, w.ec-w.pc, accountId)
+
+		for _, shard := range w.shards {
+			shardLabel := strconv.FormatUint(shard, 10)
+			ch <- prometheus.MustNewConstMetric(collector.validatorShardsDesc, prometheus.GaugeValue, 1, accountId, shardLabel)
+		}
+	}
+
+	nextValidators := make(map[string]struct{}, len(validators.NextValidators))
+	for _, v := range validators.NextValidators {
+		ch <- prometheus.MustNewConstMetric(collector.nextValidatorStakeDesc, prometheus.GaugeValue,
+			GetStakeFromString(v.Stake), v.AccountId, v.PublicKey)
+		nextValidators[v.AccountId] = struct{}{}
+	}
+	for _, accountId := range collector.accountIds {
+		_, isNextValidator := nextValidators[accountId]
+		ch <- prometheus.MustNewConstMetric(collector.nextEpochValidatorDesc, prometheus.GaugeValue, boolToFloat64(isNextValidator), accountId)
+	}
+
+	for _, v := range validators.CurrentProposals {
+		ch <- prometheus.MustNewConstMetric(collector.currentProposalStakeDesc, prometheus.GaugeValue,
+			GetStakeFromString(v.Stake), v.AccountId, v.PublicKey)
+	}
+
+	for _, v := range validators.CurrentFishermen {
+		ch <- prometheus.MustNewConstMetric(collector.fishermanStakeDesc, prometheus.GaugeValue,
+			GetStakeFromString(v.Stake), v.AccountId, "current")
+	}
+	for _, v := range validators.NextFishermen {
+		ch <- prometheus.MustNewConstMetric(collector.fishermanStakeDesc, prometheus.GaugeValue,
+			GetStakeFromString(v.Stake), v.AccountId, "next")
+	}
+}
@@ -0,0 +1,85 @@
+package collector
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	nearapi "github.com/bisontrails/near-exporter/client"
+	dto "github.com/prometheus/client_model/go"
+)
+
+func metricLabels(t *testing.T, m interface {
+	Write(*dto.Metric) error
+}) map[string]string {
+	t.Helper()
+	var pb dto.Metric
+	if err := m.Write(&pb); err != nil {
+		t.Fatalf("writing metric: %v", err)
+	}
+	labels := make(map[string]string, len(pb.Label))
+	for _, l := range pb.Label {
+		labels[l.GetName()] = l.GetValue()
+	}
+	return labels
+}
+
+func TestValidatorCollectorChunkTotalsAreNotLabeledByShard(t *testing.T) {
+	s := &snapshot{validators: nearapi.ValidatorsResult{
+		CurrentValidators: []nearapi.CurrentValidator{
+			{
+				AccountId:         "alice.near",
+				Stake:             "100",
+				Shards:            []uint64{0, 1},
+				NumProducedChunks: 40,
+				NumExpectedChunks: 50,
+			},
+		},
+	}}
+	collector := NewValidatorCollector(s, []string{"alice.near"})
+
+	var produced, shards int
+	for _, m := range collectMetrics(collector) {
+		if !strings.Contains(m.Desc().String(), "near_epoch_chunks_produced") {
+			continue
+		}
+		produced++
+		labels := metricLabels(t, m)
+		if _, ok := labels["shard"]; ok {
+			t.Errorf("near_epoch_chunks_produced carries a shard label %q, want the validator-wide aggregate only", labels["shard"])
+		}
+	}
+	for _, m := range collectMetrics(collector) {
+		if strings.Contains(m.Desc().String(), "near_validator_shards") {
+			shards++
+		}
+	}
+
+	if produced != 1 {
+		t.Errorf("near_epoch_chunks_produced emitted %d times, want exactly 1 (no duplication across shards)", produced)
+	}
+	if shards != 2 {
+		t.Errorf("near_validator_shards emitted %d times, want 1 per shard (2)", shards)
+	}
+}
+
+func TestValidatorCollectorAbsentWatchedAccountStillEmitsMetrics(t *testing.T) {
+	s := &snapshot{validators: nearapi.ValidatorsResult{}}
+	collector := NewValidatorCollector(s, []string{"nobody.near"})
+
+	metrics := collectMetrics(collector)
+	if len(metrics) == 0 {
+		t.Fatal("Collect produced no metrics for a watched account absent from the validator set")
+	}
+}
+
+func TestValidatorCollectorSnapshotError(t *testing.T) {
+	s := &snapshot{}
+	s.setErr(fmt.Errorf("rpc unavailable"))
+	collector := NewValidatorCollector(s, []string{"alice.near"})
+
+	metrics := collectMetrics(collector)
+	if len(metrics) != 14 {
+		t.Errorf("len(metrics) = %d, want 14 invalid metrics (one per desc) when the snapshot holds an error", len(metrics))
+	}
+}
@@ -0,0 +1,37 @@
+package collector
+
+import "github.com/prometheus/client_golang/prometheus"
+
+// VersionCollector exposes the internal node's software version.
+type VersionCollector struct {
+	snapshot *snapshot
+
+	versionBuildDesc *prometheus.Desc
+}
+
+func NewVersionCollector(snapshot *snapshot) *VersionCollector {
+	return &VersionCollector{
+		snapshot: snapshot,
+		versionBuildDesc: prometheus.NewDesc(
+			"near_version_build",
+			"The Near node version build",
+			[]string{"version", "build"},
+			nil,
+		),
+	}
+}
+
+func (collector *VersionCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- collector.versionBuildDesc
+}
+
+func (collector *VersionCollector) Collect(ch chan<- prometheus.Metric) {
+	internalStatus, _, _, err := collector.snapshot.get()
+	if err != nil {
+		ch <- prometheus.NewInvalidMetric(collector.versionBuildDesc, err)
+		return
+	}
+
+	versionBuildInt := HashString(internalStatus.Version.Build)
+	ch <- prometheus.MustNewConstMetric(collector.versionBuildDesc, prometheus.GaugeValue, float64(versionBuildInt), internalStatus.Version.Version, internalStatus.Version.Build)
+}
@@ -0,0 +1,50 @@
+package config
+
+import (
+	"time"
+
+	"github.com/spf13/viper"
+)
+
+// Config is the optional file read via --config, for external RPC endpoints
+// that need auth, TLS or per-endpoint timeouts beyond what the EXTERNAL_URL
+// env var alone can express.
+type Config struct {
+	ExternalEndpoints []Endpoint `mapstructure:"external_endpoints"`
+}
+
+// Endpoint describes a single external NEAR RPC endpoint.
+type Endpoint struct {
+	URL                string            `mapstructure:"url"`
+	BasicAuthUsername  string            `mapstructure:"basic_auth_username"`
+	BasicAuthPassword  string            `mapstructure:"basic_auth_password"`
+	BearerToken        string            `mapstructure:"bearer_token"`
+	Headers            map[string]string `mapstructure:"headers"`
+	CAFile             string            `mapstructure:"ca_file"`
+	InsecureSkipVerify bool              `mapstructure:"insecure_skip_verify"`
+	TimeoutSeconds     int               `mapstructure:"timeout_seconds"`
+}
+
+// Timeout returns the endpoint's configured timeout, or defaultTimeout if
+// none was set.
+func (e Endpoint) Timeout(defaultTimeout time.Duration) time.Duration {
+	if e.TimeoutSeconds <= 0 {
+		return defaultTimeout
+	}
+	return time.Duration(e.TimeoutSeconds) * time.Second
+}
+
+// Load reads and parses the config file at path.
+func Load(path string) (*Config, error) {
+	v := viper.New()
+	v.SetConfigFile(path)
+	if err := v.ReadInConfig(); err != nil {
+		return nil, err
+	}
+
+	var cfg Config
+	if err := v.Unmarshal(&cfg); err != nil {
+		return nil, err
+	}
+	return &cfg, nil
+}
@@ -0,0 +1,58 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestLoadParsesExternalEndpoints(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "near-exporter.yml")
+	contents := `
+external_endpoints:
+  - url: https://rpc-a.example.com
+    basic_auth_username: user
+    basic_auth_password: pass
+    timeout_seconds: 5
+  - url: https://rpc-b.example.com
+    bearer_token: tok
+    headers:
+      X-Api-Key: secret
+    ca_file: /etc/near-exporter/ca.pem
+    insecure_skip_verify: true
+`
+	if err := os.WriteFile(path, []byte(contents), 0o600); err != nil {
+		t.Fatalf("writing test config: %v", err)
+	}
+
+	cfg, err := Load(path)
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+	if len(cfg.ExternalEndpoints) != 2 {
+		t.Fatalf("len(ExternalEndpoints) = %d, want 2", len(cfg.ExternalEndpoints))
+	}
+
+	a := cfg.ExternalEndpoints[0]
+	if a.URL != "https://rpc-a.example.com" || a.BasicAuthUsername != "user" || a.BasicAuthPassword != "pass" {
+		t.Errorf("endpoint 0 = %+v, want matching basic auth fields", a)
+	}
+	if got, want := a.Timeout(30*time.Second), 5*time.Second; got != want {
+		t.Errorf("endpoint 0 Timeout() = %v, want %v", got, want)
+	}
+
+	b := cfg.ExternalEndpoints[1]
+	if b.BearerToken != "tok" || b.Headers["x-api-key"] != "secret" || b.CAFile != "/etc/near-exporter/ca.pem" || !b.InsecureSkipVerify {
+		t.Errorf("endpoint 1 = %+v, want matching bearer/header/TLS fields", b)
+	}
+	if got, want := b.Timeout(30*time.Second), 30*time.Second; got != want {
+		t.Errorf("endpoint 1 Timeout() = %v, want default %v when unset", got, want)
+	}
+}
+
+func TestLoadMissingFile(t *testing.T) {
+	if _, err := Load(filepath.Join(t.TempDir(), "missing.yml")); err == nil {
+		t.Fatal("Load returned nil error, want error for a missing config file")
+	}
+}
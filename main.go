@@ -2,13 +2,15 @@ package main
 
 import (
 	"flag"
-	"fmt"
 	"log"
 	"net/http"
 	"os"
+	"strings"
+	"time"
 
 	nearapi "github.com/bisontrails/near-exporter/client"
 	"github.com/bisontrails/near-exporter/collector"
+	"github.com/bisontrails/near-exporter/config"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"github.com/spf13/viper"
@@ -16,28 +18,39 @@ import (
 
 func main() {
 	configureEnvironment()
-	internalURL := viper.GetString("INTERNAL_URL")
-	externalURL := viper.GetString("EXTERNAL_URL")
-	accountID := viper.GetString("ACCOUNT_ID")
-	listenAddress := viper.GetString("LISTEN_ADDRESS")
-
+	configPath := flag.String("config", "", "path to an optional near-exporter.yml config file for external RPC endpoints")
 	flag.Parse()
 	if len(flag.Args()) > 0 {
 		flag.Usage()
 	}
 
-	client := nearapi.NewClient(internalURL)
+	internalURL := viper.GetString("INTERNAL_URL")
+	accountIDs := accountIDsFromEnv()
+	listenAddress := viper.GetString("LISTEN_ADDRESS")
+	clientTimeout := time.Duration(viper.GetInt("CLIENT_TIMEOUT_SECONDS")) * time.Second
 
-	devClient := nearapi.NewClient(externalURL)
+	client, err := nearapi.NewClient(internalURL, nearapi.Options{Timeout: clientTimeout})
+	if err != nil {
+		log.Fatalf("building internal client: %v", err)
+	}
+
+	externalPool, err := buildExternalPool(*configPath, clientTimeout)
+	if err != nil {
+		log.Fatalf("building external endpoint pool: %v", err)
+	}
 
-	rpcMetricCollector := collector.NewNodeRpcMetrics(client, devClient, accountID)
-	fmt.Println("do the thing")
-	go rpcMetricCollector.RecordValidators()
+	snap := collector.NewSnapshotPoller(client, externalPool)
 
 	registry := prometheus.NewPedanticRegistry()
 	registry.MustRegister(
-		rpcMetricCollector,
-		collector.NewDevNodeRpcMetrics(devClient),
+		nearapi.RequestDuration,
+		nearapi.RequestErrors,
+		collector.NewHeadCollector(snap),
+		collector.NewSyncCollector(snap),
+		collector.NewVersionCollector(snap),
+		collector.NewValidatorCollector(snap, accountIDs),
+		collector.NewKickoutCollector(snap),
+		collector.NewProtocolConfigCollector(externalPool, snap),
 	)
 
 	handler := promhttp.HandlerFor(registry, promhttp.HandlerOpts{
@@ -54,6 +67,65 @@ func configureEnvironment() {
 	viper.SetDefault("INTERNAL_URL", "http://localhost:3030")
 	viper.SetDefault("EXTERNAL_URL", "https://rpc.betanet.near.org")
 	viper.SetDefault("ACCOUNT_ID", "test")
+	viper.SetDefault("ACCOUNT_IDS", "")
 	viper.SetDefault("LISTEN_ADDRESS", ":9333")
 	viper.SetDefault("CLIENT_TIMEOUT_SECONDS", 30)
 }
+
+// accountIDsFromEnv returns the list of validator account IDs to watch,
+// preferring the comma-separated ACCOUNT_IDS over the legacy single-value
+// ACCOUNT_ID so existing single-account deployments keep working unchanged.
+func accountIDsFromEnv() []string {
+	if raw := viper.GetString("ACCOUNT_IDS"); raw != "" {
+		var accountIDs []string
+		for _, id := range strings.Split(raw, ",") {
+			if id = strings.TrimSpace(id); id != "" {
+				accountIDs = append(accountIDs, id)
+			}
+		}
+		if len(accountIDs) > 0 {
+			return accountIDs
+		}
+	}
+	return []string{viper.GetString("ACCOUNT_ID")}
+}
+
+// buildExternalPool builds the pool of external RPC endpoints to poll. If
+// configPath is set, endpoints (with their auth, TLS and timeout settings)
+// are read from that file; otherwise it falls back to a single endpoint
+// built from EXTERNAL_URL, to keep existing env-var-only deployments
+// working unchanged.
+func buildExternalPool(configPath string, defaultTimeout time.Duration) (*nearapi.Pool, error) {
+	if configPath == "" {
+		client, err := nearapi.NewClient(viper.GetString("EXTERNAL_URL"), nearapi.Options{Timeout: defaultTimeout})
+		if err != nil {
+			return nil, err
+		}
+		return nearapi.NewPool([]*nearapi.Client{client}, []string{viper.GetString("EXTERNAL_URL")}), nil
+	}
+
+	cfg, err := config.Load(configPath)
+	if err != nil {
+		return nil, err
+	}
+
+	clients := make([]*nearapi.Client, 0, len(cfg.ExternalEndpoints))
+	urls := make([]string, 0, len(cfg.ExternalEndpoints))
+	for _, endpoint := range cfg.ExternalEndpoints {
+		client, err := nearapi.NewClient(endpoint.URL, nearapi.Options{
+			Timeout:            endpoint.Timeout(defaultTimeout),
+			BasicAuthUsername:  endpoint.BasicAuthUsername,
+			BasicAuthPassword:  endpoint.BasicAuthPassword,
+			BearerToken:        endpoint.BearerToken,
+			Headers:            endpoint.Headers,
+			CAFile:             endpoint.CAFile,
+			InsecureSkipVerify: endpoint.InsecureSkipVerify,
+		})
+		if err != nil {
+			return nil, err
+		}
+		clients = append(clients, client)
+		urls = append(urls, endpoint.URL)
+	}
+	return nearapi.NewPool(clients, urls), nil
+}